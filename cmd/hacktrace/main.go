@@ -0,0 +1,40 @@
+// Command hacktrace dumps a binary execution trace, as recorded by
+// trace.Writer, to stdout as a human-readable table.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pqkallio/hack-emulator/trace"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: hacktrace <trace-file>")
+		os.Exit(1)
+	}
+
+	f, err := os.Open(os.Args[1])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	r := trace.NewReader(f)
+
+	for i := 0; ; i++ {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("%6d  %s\n", i, rec)
+	}
+}
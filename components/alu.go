@@ -9,6 +9,14 @@ package components
 //   ny => y = !y
 //   f  => out = two's compliment x + y, else out = x & y
 //   no => out = !out
+
+// ALUComponent is the shape both ALU and FastALU implement, so code
+// that just wants to run opcodes (the debug package's TappedALU, for
+// instance) can take either one without caring which.
+type ALUComponent interface {
+	Update(opts ...UpdateOpts) (Val, Val, Val)
+}
+
 type ALU struct {
 	x, y                  Val // inputs
 	zx, nx, zy, ny, f, no Val // flags
@@ -0,0 +1,17 @@
+package components
+
+// Component is the common interface satisfied by any chip that can be
+// driven pin by pin and clocked tick by tick, whether hand-wired in Go
+// (ALU, Register, ...) or composed at runtime from an HDL netlist by
+// the hdl package. Pin names are matched case-sensitively against the
+// chip's declared IN/OUT list.
+type Component interface {
+	// Set drives an input pin to the given value. Single-bit pins use
+	// bit 0; 16-bit pins use all 16 bits.
+	Set(pin string, val uint16)
+	// Get reads the current value of an output pin.
+	Get(pin string) uint16
+	// Tick advances any sequential state (registers, RAM, PC, ...)
+	// owned by the chip by one clock cycle.
+	Tick()
+}
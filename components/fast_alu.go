@@ -0,0 +1,109 @@
+package components
+
+// FastALU is a drop-in replacement for ALU that skips the gate-level
+// circuit simulation entirely and computes the same x/y preprocessing,
+// f/no postprocessing, and zr/ng flags directly with native uint16
+// arithmetic. Use NewALU where gate-level fidelity matters (e.g. the
+// circuit tests); use NewFastALU when raw throughput matters, such as
+// running a full ROM.
+type FastALU struct {
+	x, y                  Val // inputs
+	zx, nx, zy, ny, f, no Val // flags
+}
+
+func NewFastALU() *FastALU {
+	return &FastALU{
+		&InvalidVal{}, &InvalidVal{},
+		&InvalidVal{}, &InvalidVal{}, &InvalidVal{},
+		&InvalidVal{}, &InvalidVal{}, &InvalidVal{},
+	}
+}
+
+// Update computes the same result as ALU.Update, see its doc comment
+// for the opcode table and the UpdateOpts targets. Instead of driving
+// the Mux/Not/Add/And/Or8Way gate network bit by bit, it folds the six
+// opcode bits straight into uint16 arithmetic.
+func (alu *FastALU) Update(opts ...UpdateOpts) (Val, Val, Val) {
+	for _, opt := range opts {
+		switch opt.target {
+		case TargetX:
+			alu.x = opt.val
+		case TargetY:
+			alu.y = opt.val
+		case TargetZeroX:
+			alu.zx = opt.val
+		case TargetNegX:
+			alu.nx = opt.val
+		case TargetZeroY:
+			alu.zy = opt.val
+		case TargetNegY:
+			alu.ny = opt.val
+		case TargetFunc:
+			alu.f = opt.val
+		case TargetNegOut:
+			alu.no = opt.val
+		}
+	}
+
+	x, y := ToUint16(alu.x), ToUint16(alu.y)
+
+	if ToBool(alu.zx) {
+		x = 0
+	}
+	if ToBool(alu.nx) {
+		x = ^x
+	}
+	if ToBool(alu.zy) {
+		y = 0
+	}
+	if ToBool(alu.ny) {
+		y = ^y
+	}
+
+	var out uint16
+	if ToBool(alu.f) {
+		out = x + y
+	} else {
+		out = x & y
+	}
+	if ToBool(alu.no) {
+		out = ^out
+	}
+
+	zr := SingleChan{out == 0}
+	ng := SingleChan{out>>15 != 0}
+
+	return &SixteenChan{out}, &zr, &ng
+}
+
+// NewSixteenChan wraps v as a Val carrying a 16-bit channel's value,
+// for callers outside this package (e.g. tests comparing a gate-level
+// component against its hdl-loaded counterpart) that can't construct
+// a SixteenChan directly since its fields are unexported.
+func NewSixteenChan(v uint16) Val {
+	return &SixteenChan{v}
+}
+
+// NewSingleChan wraps b as a Val carrying a single-bit channel's
+// value, for the same cross-package callers NewSixteenChan serves.
+func NewSingleChan(b bool) Val {
+	return &SingleChan{b}
+}
+
+// ToUint16 reassembles a Val's 16 bits into a native uint16.
+func ToUint16(v Val) uint16 {
+	var out uint16
+
+	for i := uint16(0); i < 16; i++ {
+		if v.GetBoolFromUint16(i) {
+			out |= 1 << i
+		}
+	}
+
+	return out
+}
+
+// ToBool reads bit 0 of a Val, the convention single-bit channels use.
+func ToBool(v Val) bool {
+	return v.GetBoolFromUint16(0)
+}
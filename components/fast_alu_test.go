@@ -0,0 +1,96 @@
+package components
+
+import "testing"
+
+// TestFastALUMatchesOpcodeTable checks FastALU against the opcode
+// table in ALU.Update's doc comment (alu.go), the same table the
+// gate-level ALU and the hdl-loaded ALU.hdl implement.
+func TestFastALUMatchesOpcodeTable(t *testing.T) {
+	cases := []struct {
+		name                  string
+		zx, nx, zy, ny, f, no bool
+		x, y                  uint16
+		wantOut               uint16
+		wantZR, wantNG        bool
+	}{
+		{"zero", true, false, true, false, true, false, 5, 3, 0, true, false},
+		{"x+y", false, false, false, false, true, false, 5, 3, 8, false, false},
+		{"x-y", false, true, false, false, true, true, 5, 3, 2, false, false},
+		{"y-x", false, false, false, true, true, true, 5, 3, 0xFFFE, false, true}, // 3-5 == -2
+		{"x-1", false, false, true, true, true, false, 5, 3, 4, false, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			alu := NewFastALU()
+			out, zr, ng := alu.Update(
+				UpdateOpts{TargetX, &SixteenChan{c.x}},
+				UpdateOpts{TargetY, &SixteenChan{c.y}},
+				UpdateOpts{TargetZeroX, &SingleChan{c.zx}},
+				UpdateOpts{TargetNegX, &SingleChan{c.nx}},
+				UpdateOpts{TargetZeroY, &SingleChan{c.zy}},
+				UpdateOpts{TargetNegY, &SingleChan{c.ny}},
+				UpdateOpts{TargetFunc, &SingleChan{c.f}},
+				UpdateOpts{TargetNegOut, &SingleChan{c.no}},
+			)
+
+			if got := ToUint16(out); got != c.wantOut {
+				t.Errorf("out = 0x%04X, want 0x%04X", got, c.wantOut)
+			}
+			if got := ToBool(zr); got != c.wantZR {
+				t.Errorf("zr = %t, want %t", got, c.wantZR)
+			}
+			if got := ToBool(ng); got != c.wantNG {
+				t.Errorf("ng = %t, want %t", got, c.wantNG)
+			}
+		})
+	}
+}
+
+// TestFastALUMatchesGateLevelALU runs the same spread of opcodes
+// through both the gate-level ALU and FastALU and checks they agree,
+// so a divergence between the two implementations of the same opcode
+// table gets caught even if one of them drifts from the table itself.
+func TestFastALUMatchesGateLevelALU(t *testing.T) {
+	cases := []struct {
+		name                  string
+		zx, nx, zy, ny, f, no bool
+		x, y                  uint16
+	}{
+		{"zero", true, false, true, false, true, false, 5, 3},
+		{"x+y", false, false, false, false, true, false, 5, 3},
+		{"x-y", false, true, false, false, true, true, 5, 3},
+		{"y-x", false, false, false, true, true, true, 5, 3},
+		{"x-1", false, false, true, true, true, false, 5, 3},
+		{"x&y", false, false, false, false, false, false, 0x00FF, 0x0F0F},
+		{"x|y", false, true, false, true, false, true, 0x00FF, 0x0F0F},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			opts := []UpdateOpts{
+				{TargetX, &SixteenChan{c.x}},
+				{TargetY, &SixteenChan{c.y}},
+				{TargetZeroX, &SingleChan{c.zx}},
+				{TargetNegX, &SingleChan{c.nx}},
+				{TargetZeroY, &SingleChan{c.zy}},
+				{TargetNegY, &SingleChan{c.ny}},
+				{TargetFunc, &SingleChan{c.f}},
+				{TargetNegOut, &SingleChan{c.no}},
+			}
+
+			wantOut, wantZR, wantNG := NewALU().Update(opts...)
+			gotOut, gotZR, gotNG := NewFastALU().Update(opts...)
+
+			if ToUint16(gotOut) != ToUint16(wantOut) {
+				t.Errorf("out = 0x%04X, want 0x%04X", ToUint16(gotOut), ToUint16(wantOut))
+			}
+			if ToBool(gotZR) != ToBool(wantZR) {
+				t.Errorf("zr = %t, want %t", ToBool(gotZR), ToBool(wantZR))
+			}
+			if ToBool(gotNG) != ToBool(wantNG) {
+				t.Errorf("ng = %t, want %t", ToBool(gotNG), ToBool(wantNG))
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package components
+
+import "github.com/pqkallio/hack-emulator/frontend"
+
+// KeyboardBase is the address the Keyboard is mapped to in the Hack
+// address space.
+const KeyboardBase = 0x6000
+
+// Keyboard is the single memory-mapped word that reports the Hack key
+// code of whichever key is currently pressed, or 0 if none is.
+type Keyboard struct {
+	key uint16
+}
+
+func NewKeyboard() *Keyboard {
+	return &Keyboard{}
+}
+
+// Set exists so Keyboard satisfies Component; the keyboard's word is
+// driven by PollFrontend, not by the CPU writing to it.
+func (k *Keyboard) Set(pin string, val uint16) {
+	if pin == "0" {
+		k.key = val
+	}
+}
+
+func (k *Keyboard) Get(pin string) uint16 {
+	if pin == "0" {
+		return k.key
+	}
+
+	return 0
+}
+
+func (k *Keyboard) Tick() {}
+
+// PollFrontend latches the frontend's currently pressed key so the
+// CPU sees it on its next read of KeyboardBase.
+func (k *Keyboard) PollFrontend(f frontend.Frontend) {
+	k.key = f.PollKey()
+}
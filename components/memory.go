@@ -0,0 +1,38 @@
+package components
+
+import "strconv"
+
+// Memory decodes the Hack address space across plain RAM, the
+// memory-mapped Screen, and the memory-mapped Keyboard, so a CPU only
+// ever has to Read/Write a single 16-bit address space.
+type Memory struct {
+	ram      [ScreenBase]uint16 // 0x0000..0x3FFF
+	screen   *Screen            // 0x4000..0x5FFF
+	keyboard *Keyboard          // 0x6000
+}
+
+func NewMemory(screen *Screen, keyboard *Keyboard) *Memory {
+	return &Memory{screen: screen, keyboard: keyboard}
+}
+
+func (m *Memory) Read(addr uint16) uint16 {
+	switch {
+	case addr < ScreenBase:
+		return m.ram[addr]
+	case addr < KeyboardBase:
+		return m.screen.Get(strconv.Itoa(int(addr - ScreenBase)))
+	case addr == KeyboardBase:
+		return m.keyboard.Get("0")
+	default:
+		return 0
+	}
+}
+
+func (m *Memory) Write(addr, val uint16) {
+	switch {
+	case addr < ScreenBase:
+		m.ram[addr] = val
+	case addr < KeyboardBase:
+		m.screen.Set(strconv.Itoa(int(addr-ScreenBase)), val)
+	}
+}
@@ -0,0 +1,15 @@
+package components
+
+import "strconv"
+
+// wordOffset parses pin as a decimal word offset into a memory-mapped
+// Component (e.g. Screen, Keyboard) and reports whether it falls
+// within [0, size).
+func wordOffset(pin string, size int) (int, bool) {
+	i, err := strconv.Atoi(pin)
+	if err != nil || i < 0 || i >= size {
+		return 0, false
+	}
+
+	return i, true
+}
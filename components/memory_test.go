@@ -0,0 +1,27 @@
+package components
+
+import "testing"
+
+func TestMemoryRoutesScreenAndKeyboard(t *testing.T) {
+	screen := NewScreen()
+	keyboard := NewKeyboard()
+	mem := NewMemory(screen, keyboard)
+
+	mem.Write(100, 7)
+	if got := mem.Read(100); got != 7 {
+		t.Errorf("RAM read = %d, want 7", got)
+	}
+
+	mem.Write(ScreenBase+3, 0xBEEF)
+	if got := screen.Get("3"); got != 0xBEEF {
+		t.Errorf("screen.Get(\"3\") = 0x%04X, want 0xBEEF", got)
+	}
+	if got := mem.Read(ScreenBase + 3); got != 0xBEEF {
+		t.Errorf("mem.Read(ScreenBase+3) = 0x%04X, want 0xBEEF", got)
+	}
+
+	keyboard.Set("0", 65)
+	if got := mem.Read(KeyboardBase); got != 65 {
+		t.Errorf("mem.Read(KeyboardBase) = %d, want 65", got)
+	}
+}
@@ -0,0 +1,44 @@
+package components
+
+// ScreenWords is the size, in 16-bit words, of the Hack screen's
+// memory-mapped framebuffer: 256 rows of 512 monochrome pixels, 16
+// pixels per word.
+const ScreenWords = 8192
+
+// ScreenBase is the address the Screen is mapped to in the Hack
+// address space.
+const ScreenBase = 0x4000
+
+// Screen is the 256x512 monochrome framebuffer memory-mapped at
+// ScreenBase. It implements Component so a Memory decoder can route
+// addresses [ScreenBase, ScreenBase+ScreenWords) straight to it; pin
+// is the decimal word offset from ScreenBase.
+type Screen struct {
+	words [ScreenWords]uint16
+}
+
+func NewScreen() *Screen {
+	return &Screen{}
+}
+
+func (s *Screen) Set(pin string, val uint16) {
+	if i, ok := wordOffset(pin, ScreenWords); ok {
+		s.words[i] = val
+	}
+}
+
+func (s *Screen) Get(pin string) uint16 {
+	if i, ok := wordOffset(pin, ScreenWords); ok {
+		return s.words[i]
+	}
+
+	return 0
+}
+
+func (s *Screen) Tick() {}
+
+// Framebuffer returns a snapshot of screen memory for a frontend to
+// present.
+func (s *Screen) Framebuffer() [ScreenWords]uint16 {
+	return s.words
+}
@@ -0,0 +1,38 @@
+package word
+
+import "github.com/pqkallio/hack-emulator/components"
+
+// FastRegister is a drop-in replacement for Register that holds its
+// 16 bits as a native uint16 instead of driving 16 independent bit.Bit
+// components over goroutines and a channel roundtrip on every update.
+// Use NewRegister where gate-level fidelity matters; use
+// NewFastRegister when raw throughput matters.
+//
+// Like Register, Update is combinational: it reports the value the
+// register is currently holding and latches the new value only once
+// Tick is called.
+type FastRegister struct {
+	cur, next uint16
+}
+
+func NewFastRegister() *FastRegister {
+	return &FastRegister{}
+}
+
+func (reg *FastRegister) Update(in uint16, load bool, c chan components.OrderedVal16, idx int) uint16 {
+	if load {
+		reg.next = in
+	} else {
+		reg.next = reg.cur
+	}
+
+	if c != nil {
+		c <- components.OrderedVal16{Val: reg.cur, Idx: idx}
+	}
+
+	return reg.cur
+}
+
+func (reg *FastRegister) Tick() {
+	reg.cur = reg.next
+}
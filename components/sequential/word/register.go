@@ -6,6 +6,14 @@ import (
 	"github.com/pqkallio/hack-emulator/util"
 )
 
+// RegisterComponent is the shape both Register and FastRegister
+// implement, so code driving a register doesn't need to care whether
+// it's backed by 16 goroutine-driven bits or a plain uint16.
+type RegisterComponent interface {
+	Update(in uint16, load bool, c chan components.OrderedVal16, idx int) uint16
+	Tick()
+}
+
 type Register struct {
 	bits [16]*bit.Bit
 	c    chan components.OrderedVal
@@ -0,0 +1,19 @@
+package components
+
+// NewUpdateOpts builds an UpdateOpts targeting target with val, for
+// callers outside this package (e.g. tests comparing a gate-level
+// component against its hdl-loaded counterpart) that can't construct
+// one directly since its fields are unexported.
+func NewUpdateOpts(target Target, val Val) UpdateOpts {
+	return UpdateOpts{target, val}
+}
+
+// Target reports which input or flag an UpdateOpts targets.
+func (opts UpdateOpts) Target() Target {
+	return opts.target
+}
+
+// Value returns the Val an UpdateOpts carries.
+func (opts UpdateOpts) Value() Val {
+	return opts.val
+}
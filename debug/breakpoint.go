@@ -0,0 +1,66 @@
+package debug
+
+import "fmt"
+
+// Breakpoint decides, given the PC of the tick that just executed and
+// the ALU snapshot from that same tick, whether the debugger should
+// stop.
+type Breakpoint interface {
+	Hit(pc uint16, alu ALUSnapshot) bool
+	String() string
+}
+
+// AddressBreakpoint fires when the PC reaches a specific ROM address.
+type AddressBreakpoint uint16
+
+func (b AddressBreakpoint) Hit(pc uint16, _ ALUSnapshot) bool {
+	return pc == uint16(b)
+}
+
+func (b AddressBreakpoint) String() string {
+	return fmt.Sprintf("0x%04X", uint16(b))
+}
+
+// OpcodePattern fires when every non-nil field matches the
+// corresponding control bit of the opcode the ALU just ran; a nil
+// field means "don't care". Because this is a conjunction of single
+// bits, one OpcodePattern can pin down one exact opcode but not a
+// higher-level operation that spans several opcodes: f=1,no=1 alone
+// matches 1, -x, -y, x+1, y+1, x-y and y-x, not "subtraction" as a group,
+// and misses x-1/y-1 entirely (those have no=0). To break on x-1
+// specifically, match its full row from the ALU doc comment's opcode
+// table: {Zx: ptr(false), Nx: ptr(false), Zy: ptr(true), Ny: ptr(true),
+// F: ptr(true), No: ptr(false)}. Catching every subtraction variant
+// takes one breakpoint per variant.
+type OpcodePattern struct {
+	Zx, Nx, Zy, Ny, F, No *bool
+}
+
+func (p OpcodePattern) Hit(_ uint16, alu ALUSnapshot) bool {
+	return matchBit(p.Zx, alu.Zx) &&
+		matchBit(p.Nx, alu.Nx) &&
+		matchBit(p.Zy, alu.Zy) &&
+		matchBit(p.Ny, alu.Ny) &&
+		matchBit(p.F, alu.F) &&
+		matchBit(p.No, alu.No)
+}
+
+func matchBit(want *bool, got bool) bool {
+	return want == nil || *want == got
+}
+
+func (p OpcodePattern) String() string {
+	return fmt.Sprintf("opcode zx=%s nx=%s zy=%s ny=%s f=%s no=%s",
+		optBool(p.Zx), optBool(p.Nx), optBool(p.Zy), optBool(p.Ny), optBool(p.F), optBool(p.No))
+}
+
+func optBool(b *bool) string {
+	if b == nil {
+		return "*"
+	}
+	if *b {
+		return "1"
+	}
+
+	return "0"
+}
@@ -0,0 +1,49 @@
+package debug
+
+import "testing"
+
+func ptr(b bool) *bool { return &b }
+
+func TestAddressBreakpoint(t *testing.T) {
+	bp := AddressBreakpoint(0x10)
+
+	if !bp.Hit(0x10, ALUSnapshot{}) {
+		t.Errorf("expected hit at the matching address")
+	}
+	if bp.Hit(0x11, ALUSnapshot{}) {
+		t.Errorf("expected no hit at a different address")
+	}
+}
+
+// TestOpcodePatternMatchesExactOpcode checks that a pattern pinned to
+// x-1's full opcode row (see components/alu.go's table) matches that
+// opcode but not x-y, which shares f=1,no=1 with several other opcodes
+// but not x-1's zx/nx/zy/ny bits.
+func TestOpcodePatternMatchesExactOpcode(t *testing.T) {
+	xMinus1 := OpcodePattern{
+		Zx: ptr(false), Nx: ptr(false),
+		Zy: ptr(true), Ny: ptr(true),
+		F: ptr(true), No: ptr(false),
+	}
+
+	xMinus1Opcode := ALUSnapshot{Zx: false, Nx: false, Zy: true, Ny: true, F: true, No: false}
+	if !xMinus1.Hit(0, xMinus1Opcode) {
+		t.Errorf("expected pattern to match x-1's own opcode")
+	}
+
+	xMinusY := ALUSnapshot{Zx: false, Nx: true, Zy: false, Ny: false, F: true, No: true}
+	if xMinus1.Hit(0, xMinusY) {
+		t.Errorf("x-y opcode should not match the x-1 pattern")
+	}
+}
+
+func TestOpcodePatternDontCare(t *testing.T) {
+	fOnly := OpcodePattern{F: ptr(true)}
+
+	if !fOnly.Hit(0, ALUSnapshot{F: true, No: false}) {
+		t.Errorf("expected f=1 to match regardless of no")
+	}
+	if fOnly.Hit(0, ALUSnapshot{F: false}) {
+		t.Errorf("expected f=0 not to match")
+	}
+}
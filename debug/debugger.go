@@ -0,0 +1,88 @@
+package debug
+
+// Debugger drives a Machine tick by tick, stopping at breakpoints and
+// letting the caller inspect registers, flags, ALU state and memory in
+// between.
+type Debugger struct {
+	machine     Machine
+	alu         *TappedALU
+	breakpoints []Breakpoint
+}
+
+// NewDebugger wraps machine, whose ALU must already be a *TappedALU
+// (see NewTappedALU) so the debugger can read x/y/out/flags from the
+// most recently executed tick.
+func NewDebugger(machine Machine, alu *TappedALU) *Debugger {
+	return &Debugger{machine: machine, alu: alu}
+}
+
+// Break registers a breakpoint and returns its index for later removal.
+func (d *Debugger) Break(bp Breakpoint) int {
+	d.breakpoints = append(d.breakpoints, bp)
+	return len(d.breakpoints) - 1
+}
+
+// RemoveBreak removes the breakpoint returned by an earlier Break call.
+// The slot is cleared rather than spliced out, so indices returned for
+// other breakpoints stay valid.
+func (d *Debugger) RemoveBreak(idx int) {
+	d.breakpoints[idx] = nil
+}
+
+// Step executes exactly one clock tick and returns the PC it ran.
+func (d *Debugger) Step() uint16 {
+	return d.machine.Step()
+}
+
+// Continue steps the machine until a breakpoint fires or maxSteps
+// ticks have elapsed, whichever comes first, and reports whether a
+// breakpoint stopped it.
+func (d *Debugger) Continue(maxSteps int) (stopped bool, steps int) {
+	for steps = 0; steps < maxSteps; steps++ {
+		pc := d.machine.Step()
+
+		for _, bp := range d.breakpoints {
+			if bp != nil && bp.Hit(pc, d.alu.Snapshot) {
+				return true, steps + 1
+			}
+		}
+	}
+
+	return false, steps
+}
+
+// Registers reports R0..R15.
+func (d *Debugger) Registers() [16]uint16 {
+	var regs [16]uint16
+	for i := range regs {
+		regs[i] = d.machine.Register(i)
+	}
+
+	return regs
+}
+
+// Flags reports the zr and ng flags from the most recent ALU tick.
+func (d *Debugger) Flags() (zr, ng bool) {
+	return d.machine.Flags()
+}
+
+// ALU reports the x, y, out, and control-bit snapshot from the most
+// recent ALU tick.
+func (d *Debugger) ALU() ALUSnapshot {
+	return d.alu.Snapshot
+}
+
+// DumpMemory reads memory addresses in [lo, hi). If hi <= lo, it
+// returns an empty slice rather than treating the range as an error.
+func (d *Debugger) DumpMemory(lo, hi uint16) []uint16 {
+	if hi <= lo {
+		return []uint16{}
+	}
+
+	vals := make([]uint16, 0, int(hi)-int(lo))
+	for addr := lo; addr < hi; addr++ {
+		vals = append(vals, d.machine.Read(addr))
+	}
+
+	return vals
+}
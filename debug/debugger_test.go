@@ -0,0 +1,49 @@
+package debug
+
+import "testing"
+
+type fakeMachine struct {
+	pc   uint16
+	regs [16]uint16
+	mem  map[uint16]uint16
+}
+
+func (m *fakeMachine) Step() uint16 {
+	m.pc++
+	return m.pc
+}
+
+func (m *fakeMachine) Register(n int) uint16 { return m.regs[n] }
+
+func (m *fakeMachine) Flags() (zr, ng bool) { return false, false }
+
+func (m *fakeMachine) Read(addr uint16) uint16 { return m.mem[addr] }
+
+func TestDumpMemoryReadsRange(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{256: 1, 257: 2, 258: 3}}
+	d := NewDebugger(m, NewTappedALU(nil))
+
+	got := d.DumpMemory(256, 259)
+	want := []uint16{1, 2, 3}
+
+	if len(got) != len(want) {
+		t.Fatalf("len(got) = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got[%d] = %d, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDumpMemoryEmptyRangeDoesNotPanic(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{}}
+	d := NewDebugger(m, NewTappedALU(nil))
+
+	if got := d.DumpMemory(10, 5); len(got) != 0 {
+		t.Errorf("DumpMemory(10, 5) = %v, want empty", got)
+	}
+	if got := d.DumpMemory(10, 10); len(got) != 0 {
+		t.Errorf("DumpMemory(10, 10) = %v, want empty", got)
+	}
+}
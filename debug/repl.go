@@ -0,0 +1,132 @@
+package debug
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// REPL is a minimal interactive front end for Debugger, understanding
+// the commands:
+//
+//	step              execute one clock tick
+//	continue          run until a breakpoint fires, or 1<<20 steps
+//	break 0x1234      set a breakpoint at a ROM address
+//	print R0..R15     print a register, or "flags" / "alu"
+//	watch RAM[256]    print a single memory address
+//	quit              exit the REPL
+type REPL struct {
+	dbg *Debugger
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+// NewREPL builds a REPL reading commands from in and writing responses
+// to out.
+func NewREPL(dbg *Debugger, in io.Reader, out io.Writer) *REPL {
+	return &REPL{dbg: dbg, in: bufio.NewScanner(in), out: out}
+}
+
+// Run processes commands from in until "quit" or EOF.
+func (r *REPL) Run() {
+	for r.in.Scan() {
+		line := strings.TrimSpace(r.in.Text())
+		if line == "" {
+			continue
+		}
+
+		if line == "quit" {
+			return
+		}
+
+		if err := r.dispatch(line); err != nil {
+			fmt.Fprintf(r.out, "error: %s\n", err)
+		}
+	}
+}
+
+func (r *REPL) dispatch(line string) error {
+	fields := strings.Fields(line)
+	cmd, args := fields[0], fields[1:]
+
+	switch cmd {
+	case "step":
+		pc := r.dbg.Step()
+		fmt.Fprintf(r.out, "pc=0x%04X\n", pc)
+	case "continue":
+		stopped, steps := r.dbg.Continue(1 << 20)
+		if stopped {
+			fmt.Fprintf(r.out, "breakpoint hit after %d steps\n", steps)
+		} else {
+			fmt.Fprintf(r.out, "ran %d steps without hitting a breakpoint\n", steps)
+		}
+	case "break":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: break 0x1234")
+		}
+
+		addr, err := strconv.ParseUint(strings.TrimPrefix(args[0], "0x"), 16, 16)
+		if err != nil {
+			return fmt.Errorf("bad address %q: %w", args[0], err)
+		}
+
+		idx := r.dbg.Break(AddressBreakpoint(addr))
+		fmt.Fprintf(r.out, "breakpoint %d set at 0x%04X\n", idx, addr)
+	case "print":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: print R0 | print flags | print alu")
+		}
+
+		return r.print(args[0])
+	case "watch":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: watch RAM[256]")
+		}
+
+		return r.watch(args[0])
+	default:
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+
+	return nil
+}
+
+func (r *REPL) print(target string) error {
+	switch {
+	case target == "flags":
+		zr, ng := r.dbg.Flags()
+		fmt.Fprintf(r.out, "zr=%t ng=%t\n", zr, ng)
+	case target == "alu":
+		a := r.dbg.ALU()
+		fmt.Fprintf(r.out, "x=0x%04X y=0x%04X out=0x%04X f=%t no=%t zr=%t ng=%t\n",
+			a.X, a.Y, a.Out, a.F, a.No, a.ZR, a.NG)
+	case strings.HasPrefix(target, "R"):
+		n, err := strconv.Atoi(target[1:])
+		if err != nil || n < 0 || n > 15 {
+			return fmt.Errorf("bad register %q", target)
+		}
+
+		fmt.Fprintf(r.out, "%s=0x%04X\n", target, r.dbg.machine.Register(n))
+	default:
+		return fmt.Errorf("unknown print target %q", target)
+	}
+
+	return nil
+}
+
+func (r *REPL) watch(target string) error {
+	if !strings.HasPrefix(target, "RAM[") || !strings.HasSuffix(target, "]") {
+		return fmt.Errorf("usage: watch RAM[256]")
+	}
+
+	addr, err := strconv.ParseUint(target[len("RAM["):len(target)-1], 10, 16)
+	if err != nil {
+		return fmt.Errorf("bad address %q: %w", target, err)
+	}
+
+	fmt.Fprintf(r.out, "%s=0x%04X\n", target, r.dbg.machine.Read(uint16(addr)))
+
+	return nil
+}
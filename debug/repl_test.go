@@ -0,0 +1,134 @@
+package debug
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func newTestREPL(m Machine) (*REPL, *bytes.Buffer) {
+	var out bytes.Buffer
+	return NewREPL(NewDebugger(m, NewTappedALU(nil)), nil, &out), &out
+}
+
+func TestREPLStep(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{}}
+	repl, out := newTestREPL(m)
+
+	if err := repl.dispatch("step"); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if got, want := out.String(), "pc=0x0001\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestREPLBreak(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{}}
+	repl, out := newTestREPL(m)
+
+	if err := repl.dispatch("break 0x1234"); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if got, want := out.String(), "breakpoint 0 set at 0x1234\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestREPLBreakRejectsMalformedAddress(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{}}
+	repl, _ := newTestREPL(m)
+
+	if err := repl.dispatch("break nope"); err == nil {
+		t.Fatal("expected an error for a malformed address")
+	}
+	if err := repl.dispatch("break"); err == nil {
+		t.Fatal("expected an error for a missing address")
+	}
+}
+
+func TestREPLPrintRegisterFlagsAndALU(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{}}
+	m.regs[3] = 0x00FF
+	repl, out := newTestREPL(m)
+
+	if err := repl.dispatch("print R3"); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if got, want := out.String(), "R3=0x00FF\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+
+	out.Reset()
+	if err := repl.dispatch("print flags"); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if got, want := out.String(), "zr=false ng=false\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+
+	out.Reset()
+	if err := repl.dispatch("print alu"); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "x=0x0000 y=0x0000") {
+		t.Errorf("output = %q, want it to start with the ALU snapshot", out.String())
+	}
+}
+
+func TestREPLPrintRejectsUnknownTarget(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{}}
+	repl, _ := newTestREPL(m)
+
+	if err := repl.dispatch("print R16"); err == nil {
+		t.Fatal("expected an error for a register out of range")
+	}
+	if err := repl.dispatch("print nonsense"); err == nil {
+		t.Fatal("expected an error for an unknown print target")
+	}
+}
+
+func TestREPLWatch(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{256: 99}}
+	repl, out := newTestREPL(m)
+
+	if err := repl.dispatch("watch RAM[256]"); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if got, want := out.String(), "RAM[256]=0x0063\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestREPLWatchRejectsMalformedTarget(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{}}
+	repl, _ := newTestREPL(m)
+
+	if err := repl.dispatch("watch RAM[oops]"); err == nil {
+		t.Fatal("expected an error for a malformed watch target")
+	}
+	if err := repl.dispatch("watch R0"); err == nil {
+		t.Fatal("expected an error for a watch target missing RAM[...] syntax")
+	}
+}
+
+func TestREPLRejectsUnknownCommand(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{}}
+	repl, _ := newTestREPL(m)
+
+	if err := repl.dispatch("frobnicate"); err == nil {
+		t.Fatal("expected an error for an unknown command")
+	}
+}
+
+func TestREPLRunStopsAtQuit(t *testing.T) {
+	m := &fakeMachine{mem: map[uint16]uint16{}}
+	var out bytes.Buffer
+	repl := NewREPL(NewDebugger(m, NewTappedALU(nil)), strings.NewReader("step\nstep\nquit\nstep\n"), &out)
+
+	repl.Run()
+
+	if got, want := out.String(), "pc=0x0001\npc=0x0002\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
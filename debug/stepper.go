@@ -0,0 +1,36 @@
+// Package debug drives a Hack machine one clock tick at a time instead
+// of free-running it, so a caller can set breakpoints, single-step,
+// and inspect registers, flags and ALU state between ticks.
+package debug
+
+// Stepper is implemented by anything that can be clocked one tick at a
+// time. The debugger drives the machine exclusively through this
+// interface rather than a free-running execution loop.
+type Stepper interface {
+	// Step executes exactly one clock tick and reports the ROM
+	// address the instruction at that tick was fetched from.
+	Step() (pc uint16)
+}
+
+// Registers exposes a machine's visible register file and ALU status
+// flags for inspection between steps.
+type Registers interface {
+	// Register returns the current value of R0..R15.
+	Register(n int) uint16
+	// Flags returns the zr and ng flags set by the most recent ALU
+	// computation.
+	Flags() (zr, ng bool)
+}
+
+// Memory exposes a machine's addressable memory for inspection.
+type Memory interface {
+	Read(addr uint16) uint16
+}
+
+// Machine is the full surface the debugger needs from whatever it is
+// attached to.
+type Machine interface {
+	Stepper
+	Registers
+	Memory
+}
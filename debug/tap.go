@@ -0,0 +1,57 @@
+package debug
+
+import "github.com/pqkallio/hack-emulator/components"
+
+// ALUSnapshot is the x, y and result of the most recent ALU.Update
+// call.
+type ALUSnapshot struct {
+	X, Y, Out      uint16
+	Zx, Nx, Zy, Ny bool // the opcode's x/y preprocessing control bits
+	F, No          bool // the opcode's function/negate-output control bits
+	ZR, NG         bool
+}
+
+// TappedALU wraps an ALUComponent and records its inputs and outputs
+// on every Update call into Snapshot, which is reused across ticks so
+// recording never allocates.
+type TappedALU struct {
+	alu      components.ALUComponent
+	Snapshot ALUSnapshot
+}
+
+// NewTappedALU wraps alu, which may be either a gate-level ALU or a
+// FastALU.
+func NewTappedALU(alu components.ALUComponent) *TappedALU {
+	return &TappedALU{alu: alu}
+}
+
+func (t *TappedALU) Update(opts ...components.UpdateOpts) (components.Val, components.Val, components.Val) {
+	for _, opt := range opts {
+		switch opt.Target() {
+		case components.TargetX:
+			t.Snapshot.X = components.ToUint16(opt.Value())
+		case components.TargetY:
+			t.Snapshot.Y = components.ToUint16(opt.Value())
+		case components.TargetZeroX:
+			t.Snapshot.Zx = components.ToBool(opt.Value())
+		case components.TargetNegX:
+			t.Snapshot.Nx = components.ToBool(opt.Value())
+		case components.TargetZeroY:
+			t.Snapshot.Zy = components.ToBool(opt.Value())
+		case components.TargetNegY:
+			t.Snapshot.Ny = components.ToBool(opt.Value())
+		case components.TargetFunc:
+			t.Snapshot.F = components.ToBool(opt.Value())
+		case components.TargetNegOut:
+			t.Snapshot.No = components.ToBool(opt.Value())
+		}
+	}
+
+	out, zr, ng := t.alu.Update(opts...)
+
+	t.Snapshot.Out = components.ToUint16(out)
+	t.Snapshot.ZR = components.ToBool(zr)
+	t.Snapshot.NG = components.ToBool(ng)
+
+	return out, zr, ng
+}
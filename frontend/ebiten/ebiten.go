@@ -0,0 +1,113 @@
+//go:build ebiten
+
+// Package ebiten renders the Hack screen and captures keyboard input
+// using the ebiten game engine, so a user can actually watch a ROM
+// like Pong run.
+package ebiten
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/pqkallio/hack-emulator/frontend"
+)
+
+const (
+	screenWidth  = 512
+	screenHeight = 256
+)
+
+// Frontend renders the Hack screen via ebiten and translates ebiten
+// key events into Hack keyboard codes. It satisfies frontend.Frontend
+// and, so it can drive its own window, ebiten.Game as well.
+type Frontend struct {
+	img    *ebiten.Image
+	keyMap map[ebiten.Key]uint16
+}
+
+var _ frontend.Frontend = (*Frontend)(nil)
+
+// New returns a Frontend. Pass it to ebiten.RunGame to open a window;
+// a caller embedding the machine in its own game loop can instead call
+// Present/PollKey directly and ignore Update/Draw/Layout.
+func New() *Frontend {
+	return &Frontend{
+		img:    ebiten.NewImage(screenWidth, screenHeight),
+		keyMap: defaultKeyMap(),
+	}
+}
+
+func (f *Frontend) Present(framebuffer [8192]uint16) {
+	const wordsPerRow = screenWidth / 16
+
+	pix := image.NewRGBA(image.Rect(0, 0, screenWidth, screenHeight))
+
+	for word, bits := range framebuffer {
+		row := word / wordsPerRow
+		col0 := (word % wordsPerRow) * 16
+
+		for bit := 0; bit < 16; bit++ {
+			c := color.White
+			if bits&(1<<uint(bit)) != 0 {
+				c = color.Black
+			}
+			pix.Set(col0+bit, row, c)
+		}
+	}
+
+	f.img.WritePixels(pix.Pix)
+}
+
+func (f *Frontend) PollKey() uint16 {
+	for ek, hackCode := range f.keyMap {
+		if ebiten.IsKeyPressed(ek) {
+			return hackCode
+		}
+	}
+
+	return 0
+}
+
+func (f *Frontend) Update() error { return nil }
+
+func (f *Frontend) Draw(screen *ebiten.Image) {
+	screen.DrawImage(f.img, nil)
+}
+
+func (f *Frontend) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return screenWidth, screenHeight
+}
+
+// defaultKeyMap follows the Hack keyboard code table: printable ASCII
+// maps to itself, and the named keys below map to the codes above 127
+// nand2tetris reserves for them.
+func defaultKeyMap() map[ebiten.Key]uint16 {
+	m := map[ebiten.Key]uint16{
+		ebiten.KeyEnter:      128,
+		ebiten.KeyBackspace:  129,
+		ebiten.KeyArrowLeft:  130,
+		ebiten.KeyArrowUp:    131,
+		ebiten.KeyArrowRight: 132,
+		ebiten.KeyArrowDown:  133,
+		ebiten.KeyHome:       134,
+		ebiten.KeyEnd:        135,
+		ebiten.KeyPageUp:     136,
+		ebiten.KeyPageDown:   137,
+		ebiten.KeyInsert:     138,
+		ebiten.KeyDelete:     139,
+		ebiten.KeyEscape:     140,
+		ebiten.KeySpace:      32,
+	}
+
+	for c := 0; c < 26; c++ {
+		m[ebiten.Key(int(ebiten.KeyA)+c)] = uint16('A' + c)
+	}
+
+	for d := 0; d < 10; d++ {
+		m[ebiten.Key(int(ebiten.Key0)+d)] = uint16('0' + d)
+	}
+
+	return m
+}
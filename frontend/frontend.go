@@ -0,0 +1,15 @@
+// Package frontend decouples the Hack machine's Screen and Keyboard
+// components from how they are actually presented to a user, so the
+// same CPU loop can run headless under test or against a real window.
+package frontend
+
+// Frontend presents the Hack screen's framebuffer and reports which
+// key is currently pressed, translated to the Hack keyboard code.
+type Frontend interface {
+	// Present renders one frame: 256 rows of 512 monochrome pixels,
+	// 16 per word, in row-major order, as read from components.Screen.
+	Present(framebuffer [8192]uint16)
+	// PollKey returns the Hack keyboard code of the key currently
+	// held down, or 0 if none is.
+	PollKey() uint16
+}
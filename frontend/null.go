@@ -0,0 +1,15 @@
+package frontend
+
+// NullFrontend is a headless Frontend for tests and for running a ROM
+// with no display or input attached.
+type NullFrontend struct{}
+
+func NewNullFrontend() *NullFrontend {
+	return &NullFrontend{}
+}
+
+func (NullFrontend) Present(framebuffer [8192]uint16) {}
+
+func (NullFrontend) PollKey() uint16 {
+	return 0
+}
@@ -0,0 +1,36 @@
+// Package hdl loads nand2tetris-style HDL chip definitions and composes
+// them, at runtime, into a components.Component built out of the
+// primitives it already knows about. It lets a user swap in their own
+// HDL implementation of a chip (e.g. a homemade ALU) without
+// recompiling the simulator.
+package hdl
+
+// Pin is a single IN or OUT declaration on a chip, e.g. "sel" or the
+// bus form "in[16]".
+type Pin struct {
+	Name  string
+	Width int // 1 for a plain pin, >1 for a bus such as in[16]
+}
+
+// Connection binds one of a part's pins to a wire in the enclosing
+// chip. `Mux(a=x, b=y, sel=loadX, out=z)` yields one Connection per
+// "pin=wire" pair.
+type Connection struct {
+	Pin  string
+	Wire string
+}
+
+// Part is a single PARTS: entry, e.g. `And(a=x, b=y, out=z);`.
+type Part struct {
+	ChipName    string
+	Connections []Connection
+}
+
+// ChipDef is the parsed form of a `CHIP name { IN ...; OUT ...; PARTS: ... }`
+// block.
+type ChipDef struct {
+	Name  string
+	In    []Pin
+	Out   []Pin
+	Parts []Part
+}
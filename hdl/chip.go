@@ -0,0 +1,96 @@
+package hdl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pqkallio/hack-emulator/components"
+)
+
+// loader resolves chip names to Components, either from the builtin
+// registry or by parsing a sibling "<ChipName>.hdl" file. It caches
+// parsed ChipDefs so a chip used by many parts (e.g. Not, Mux) is
+// only read and parsed once per LoadChip call.
+type loader struct {
+	dir  string
+	defs map[string]*ChipDef
+}
+
+// LoadChip parses the HDL file at path and returns a runnable
+// components.Component built by composing the builtin primitives
+// (Nand, DFF) and any other chip files found alongside it. A user can
+// swap in their own implementation of, say, ALU.hdl by pointing
+// LoadChip at a directory containing it instead of hdl/chips.
+func LoadChip(path string) (components.Component, error) {
+	l := &loader{dir: filepath.Dir(path), defs: map[string]*ChipDef{}}
+
+	def, err := l.parseFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := detectCycles(def); err != nil {
+		return nil, fmt.Errorf("hdl: %s: %w", def.Name, err)
+	}
+
+	return l.build(def)
+}
+
+func (l *loader) parseFile(path string) (*ChipDef, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("hdl: %w", err)
+	}
+
+	def, err := parseChip(string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	l.defs[def.Name] = def
+
+	return def, nil
+}
+
+// resolve returns a Component for chipName, instantiating it from the
+// builtin registry or by loading "<chipName>.hdl" from the directory
+// the top-level chip was loaded from.
+func (l *loader) resolve(chipName string) (components.Component, error) {
+	if ctor, ok := builtin[chipName]; ok {
+		return ctor(), nil
+	}
+
+	def, ok := l.defs[chipName]
+	if !ok {
+		var err error
+
+		def, err = l.parseFile(filepath.Join(l.dir, chipName+".hdl"))
+		if err != nil {
+			return nil, fmt.Errorf("hdl: resolving %s: %w", chipName, err)
+		}
+
+		if err := detectCycles(def); err != nil {
+			return nil, fmt.Errorf("hdl: %s: %w", chipName, err)
+		}
+	}
+
+	return l.build(def)
+}
+
+// build instantiates every part of def and wires them into a runnable
+// netlist.
+func (l *loader) build(def *ChipDef) (components.Component, error) {
+	n := &netlist{def: def, wires: map[string]uint16{}}
+
+	for _, part := range def.Parts {
+		comp, err := l.resolve(part.ChipName)
+		if err != nil {
+			return nil, fmt.Errorf("hdl: %s: %w", def.Name, err)
+		}
+
+		n.parts = append(n.parts, netlistPart{part: part, comp: comp})
+	}
+
+	return n, nil
+}
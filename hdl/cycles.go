@@ -0,0 +1,75 @@
+package hdl
+
+import "fmt"
+
+// detectCycles rejects a ChipDef whose PARTS form a combinational loop:
+// two or more non-sequential parts feeding each other's inputs within
+// the same tick, which would never settle. It does not inspect nested
+// chips: a nested chip counts as sequential only if its name is in the
+// `sequential` map, so a chip under any other name that embeds a DFF
+// is treated as combinational here.
+func detectCycles(def *ChipDef) error {
+	producer := map[string]int{}
+
+	for i, part := range def.Parts {
+		for _, conn := range part.Connections {
+			if isOutputPinName(conn.Pin) {
+				producer[conn.Wire] = i
+			}
+		}
+	}
+
+	adj := make([][]int, len(def.Parts))
+
+	for i, part := range def.Parts {
+		if sequential[part.ChipName] {
+			continue // this tick's inputs don't reach this tick's outputs
+		}
+
+		for _, conn := range part.Connections {
+			if isOutputPinName(conn.Pin) {
+				continue
+			}
+
+			if j, ok := producer[conn.Wire]; ok && !sequential[def.Parts[j].ChipName] {
+				adj[j] = append(adj[j], i)
+			}
+		}
+	}
+
+	const (
+		white = iota
+		gray
+		black
+	)
+	color := make([]int, len(def.Parts))
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		color[i] = gray
+
+		for _, j := range adj[i] {
+			switch color[j] {
+			case gray:
+				return fmt.Errorf("combinational cycle through %s and %s", def.Parts[i].ChipName, def.Parts[j].ChipName)
+			case white:
+				if err := visit(j); err != nil {
+					return err
+				}
+			}
+		}
+
+		color[i] = black
+		return nil
+	}
+
+	for i := range def.Parts {
+		if color[i] == white {
+			if err := visit(i); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package hdl
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/pqkallio/hack-emulator/components"
+)
+
+var busPinRe = regexp.MustCompile(`^(\w+)\[(\d+)\]$`)
+
+// pinLister is implemented by everything hdl itself builds (the two
+// builtins and nested chips) so settle() can tell which of a part's
+// pins are outputs without guessing at naming conventions.
+type pinLister interface {
+	ins() []string
+	outs() []string
+}
+
+// netlist is the runnable form of a ChipDef: a set of named wires plus
+// the part Components wired between them.
+type netlist struct {
+	def   *ChipDef
+	wires map[string]uint16
+	parts []netlistPart
+}
+
+type netlistPart struct {
+	part Part
+	comp components.Component
+}
+
+func (n *netlist) Set(pin string, val uint16) {
+	n.setWire(pin, val)
+	n.settle()
+}
+
+func (n *netlist) Get(pin string) uint16 {
+	return n.wireValue(pin)
+}
+
+func (n *netlist) Tick() {
+	for _, p := range n.parts {
+		p.comp.Tick()
+	}
+
+	n.settle()
+}
+
+func (n *netlist) ins() []string  { return pinNames(n.def.In) }
+func (n *netlist) outs() []string { return pinNames(n.def.Out) }
+
+func pinNames(pins []Pin) []string {
+	names := make([]string, len(pins))
+	for i, p := range pins {
+		names[i] = p.Name
+	}
+
+	return names
+}
+
+// settle re-evaluates every part until no wire changes, propagating
+// input pin values through to outputs. Every part is either
+// feed-forward or bottoms out at a DFF (see the `sequential` map), so
+// this converges in at most len(parts) passes.
+func (n *netlist) settle() {
+	for pass := 0; pass <= len(n.parts); pass++ {
+		changed := false
+
+		for _, np := range n.parts {
+			for _, conn := range np.part.Connections {
+				if isOutput(np.comp, conn.Pin) {
+					continue
+				}
+				np.comp.Set(conn.Pin, n.wireValue(conn.Wire))
+			}
+
+			for _, conn := range np.part.Connections {
+				if !isOutput(np.comp, conn.Pin) {
+					continue
+				}
+
+				val := np.comp.Get(conn.Pin)
+				if n.wireValue(conn.Wire) != val {
+					n.setWire(conn.Wire, val)
+					changed = true
+				}
+			}
+		}
+
+		if !changed {
+			return
+		}
+	}
+}
+
+// wireValue returns the value of a wire reference, decoding the
+// constant pins `true`/`false` and bus indices like "out[3]" as a
+// single bit of the underlying "out" wire.
+func (n *netlist) wireValue(ref string) uint16 {
+	switch ref {
+	case "true":
+		return 0xFFFF
+	case "false":
+		return 0
+	}
+
+	if m := busPinRe.FindStringSubmatch(ref); m != nil {
+		bit, _ := strconv.Atoi(m[2])
+		return (n.wires[m[1]] >> uint(bit)) & 1
+	}
+
+	return n.wires[ref]
+}
+
+// setWire stores val on a wire reference, folding a bus index like
+// "out[3]" into the corresponding bit of the underlying "out" wire.
+func (n *netlist) setWire(ref string, val uint16) {
+	if m := busPinRe.FindStringSubmatch(ref); m != nil {
+		bit, _ := strconv.Atoi(m[2])
+
+		if val&1 != 0 {
+			n.wires[m[1]] |= 1 << uint(bit)
+		} else {
+			n.wires[m[1]] &^= 1 << uint(bit)
+		}
+
+		return
+	}
+
+	n.wires[ref] = val
+}
@@ -0,0 +1,99 @@
+package hdl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	commentRe = regexp.MustCompile(`(?s)/\*.*?\*/|//[^\n]*`)
+	chipRe    = regexp.MustCompile(`(?s)CHIP\s+(\w+)\s*\{(.*)\}`)
+	pinRe     = regexp.MustCompile(`^(\w+)(?:\[(\d+)\])?$`)
+	partRe    = regexp.MustCompile(`^(\w+)\s*\(([^)]*)\)$`)
+)
+
+// parseChip parses the body of a single `CHIP name { ... }` block. It
+// supports the subset of the nand2tetris HDL grammar the loader needs:
+// IN/OUT pin lists and a PARTS section of `Chip(pin=wire, ...);` calls.
+func parseChip(src string) (*ChipDef, error) {
+	src = commentRe.ReplaceAllString(src, "")
+
+	m := chipRe.FindStringSubmatch(src)
+	if m == nil {
+		return nil, fmt.Errorf("hdl: no CHIP block found")
+	}
+
+	def := &ChipDef{Name: m[1]}
+	body := strings.Replace(m[2], "PARTS:", "@PARTS@;", 1)
+
+	inParts := false
+	for _, stmt := range strings.Split(body, ";") {
+		stmt = strings.TrimSpace(stmt)
+
+		switch {
+		case stmt == "":
+			continue
+		case stmt == "@PARTS@":
+			inParts = true
+		case inParts:
+			part, err := parsePart(stmt)
+			if err != nil {
+				return nil, fmt.Errorf("hdl: %s: %w", def.Name, err)
+			}
+			def.Parts = append(def.Parts, part)
+		case strings.HasPrefix(stmt, "IN "):
+			def.In = parsePins(stmt[len("IN "):])
+		case strings.HasPrefix(stmt, "OUT "):
+			def.Out = parsePins(stmt[len("OUT "):])
+		default:
+			return nil, fmt.Errorf("hdl: %s: unrecognized statement %q", def.Name, stmt)
+		}
+	}
+
+	return def, nil
+}
+
+func parsePins(s string) []Pin {
+	var pins []Pin
+
+	for _, name := range strings.Split(s, ",") {
+		m := pinRe.FindStringSubmatch(strings.TrimSpace(name))
+		if m == nil {
+			continue
+		}
+
+		width := 1
+		if m[2] != "" {
+			width, _ = strconv.Atoi(m[2])
+		}
+
+		pins = append(pins, Pin{Name: m[1], Width: width})
+	}
+
+	return pins
+}
+
+func parsePart(s string) (Part, error) {
+	m := partRe.FindStringSubmatch(s)
+	if m == nil {
+		return Part{}, fmt.Errorf("malformed part %q", s)
+	}
+
+	part := Part{ChipName: m[1]}
+
+	for _, conn := range strings.Split(m[2], ",") {
+		kv := strings.SplitN(strings.TrimSpace(conn), "=", 2)
+		if len(kv) != 2 {
+			return Part{}, fmt.Errorf("malformed connection %q in %s", conn, s)
+		}
+
+		part.Connections = append(part.Connections, Connection{
+			Pin:  strings.TrimSpace(kv[0]),
+			Wire: strings.TrimSpace(kv[1]),
+		})
+	}
+
+	return part, nil
+}
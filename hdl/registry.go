@@ -0,0 +1,110 @@
+package hdl
+
+import "github.com/pqkallio/hack-emulator/components"
+
+// builtin are the chips the loader cannot derive from HDL, because
+// they're the primitives HDL composition bottoms out at: Nand is the
+// one gate nand2tetris takes as given, and DFF is the one piece of
+// state a clock actually latches. Every other chip in hdl/chips/,
+// including Register and ALU, is built from these two.
+var builtin = map[string]func() components.Component{
+	"Nand": func() components.Component { return &nand{} },
+	"DFF":  func() components.Component { return &dff{} },
+}
+
+type nand struct {
+	a, b, out uint16
+}
+
+func (n *nand) Set(pin string, val uint16) {
+	switch pin {
+	case "a":
+		n.a = val & 1
+	case "b":
+		n.b = val & 1
+	}
+
+	n.out = (n.a & n.b) ^ 1
+}
+
+func (n *nand) Get(pin string) uint16 {
+	if pin == "out" {
+		return n.out
+	}
+
+	return 0
+}
+
+func (n *nand) Tick()          {}
+func (n *nand) ins() []string  { return []string{"a", "b"} }
+func (n *nand) outs() []string { return []string{"out"} }
+
+// dff is a clocked data flip-flop: out reflects whatever in was set to
+// as of the most recent Tick, not the current value of in.
+type dff struct {
+	cur, next uint16
+}
+
+func (d *dff) Set(pin string, val uint16) {
+	if pin == "in" {
+		d.next = val
+	}
+}
+
+func (d *dff) Get(pin string) uint16 {
+	if pin == "out" {
+		return d.cur
+	}
+
+	return 0
+}
+
+func (d *dff) Tick()          { d.cur = d.next }
+func (d *dff) ins() []string  { return []string{"in"} }
+func (d *dff) outs() []string { return []string{"out"} }
+
+// sequential chips decouple their output from the current tick's
+// input, so a wire they drive never closes a combinational cycle even
+// if it feeds back into one of their own inputs downstream.
+var sequential = map[string]bool{
+	"DFF":      true,
+	"Bit":      true,
+	"Register": true,
+	"PC":       true,
+	"RAM8":     true,
+	"RAM64":    true,
+	"RAM512":   true,
+	"RAM4K":    true,
+	"RAM16K":   true,
+}
+
+// isOutput reports whether pin is one of comp's output pins. Builtins
+// and nested chips all implement pinLister, so this is exact; the
+// conventional-name fallback only matters for a components.Component
+// that doesn't.
+func isOutput(comp components.Component, pin string) bool {
+	pl, ok := comp.(pinLister)
+	if !ok {
+		return isOutputPinName(pin)
+	}
+
+	for _, o := range pl.outs() {
+		if o == pin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOutputPinName reports whether pin is a standard nand2tetris output
+// pin name, used as a fallback when a Component doesn't implement
+// pinLister.
+func isOutputPinName(pin string) bool {
+	switch pin {
+	case "out", "zr", "ng", "sum", "carry":
+		return true
+	default:
+		return false
+	}
+}
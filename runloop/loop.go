@@ -0,0 +1,63 @@
+// Package runloop ticks a machine at a configurable clock rate while a
+// frontend.Frontend drives vsync, presenting the screen and polling
+// the keyboard once per tick. This is what lets a frontend such as the
+// ebiten one actually run something like the Pong demo, rather than
+// sitting unconnected to the rest of the simulator.
+package runloop
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pqkallio/hack-emulator/components"
+	"github.com/pqkallio/hack-emulator/debug"
+	"github.com/pqkallio/hack-emulator/frontend"
+)
+
+// Loop drives a debug.Stepper (a gate-level or fast-path machine
+// alike) at a configurable clock rate, presenting screen's
+// framebuffer and polling fe for keyboard input after every tick.
+type Loop struct {
+	machine  debug.Stepper
+	screen   *components.Screen
+	keyboard *components.Keyboard
+	fe       frontend.Frontend
+	hz       int
+}
+
+// NewLoop builds a Loop that ticks machine hz times per second. hz
+// must be positive, since it becomes a ticker period's divisor.
+func NewLoop(machine debug.Stepper, screen *components.Screen, keyboard *components.Keyboard, fe frontend.Frontend, hz int) (*Loop, error) {
+	if hz <= 0 {
+		return nil, fmt.Errorf("runloop: hz must be positive, got %d", hz)
+	}
+
+	return &Loop{machine: machine, screen: screen, keyboard: keyboard, fe: fe, hz: hz}, nil
+}
+
+// Tick executes one clock tick and reports the PC it ran, then
+// updates the keyboard from the frontend and presents the current
+// framebuffer.
+func (l *Loop) Tick() uint16 {
+	pc := l.machine.Step()
+
+	l.keyboard.PollFrontend(l.fe)
+	l.fe.Present(l.screen.Framebuffer())
+
+	return pc
+}
+
+// Run calls Tick at the configured clock rate until stop is closed.
+func (l *Loop) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(time.Second / time.Duration(l.hz))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			l.Tick()
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package runloop
+
+import (
+	"testing"
+
+	"github.com/pqkallio/hack-emulator/components"
+	"github.com/pqkallio/hack-emulator/frontend"
+)
+
+type scriptedStepper struct {
+	pc uint16
+}
+
+func (s *scriptedStepper) Step() uint16 {
+	s.pc++
+	return s.pc
+}
+
+func TestLoopTickStepsMachineAndDrivesFrontend(t *testing.T) {
+	stepper := &scriptedStepper{}
+	screen := components.NewScreen()
+	keyboard := components.NewKeyboard()
+	fe := frontend.NewNullFrontend()
+
+	loop, err := NewLoop(stepper, screen, keyboard, fe, 60)
+	if err != nil {
+		t.Fatalf("NewLoop: %v", err)
+	}
+
+	if pc := loop.Tick(); pc != 1 {
+		t.Errorf("Tick() = %d, want 1", pc)
+	}
+	if pc := loop.Tick(); pc != 2 {
+		t.Errorf("Tick() = %d, want 2", pc)
+	}
+}
+
+func TestNewLoopRejectsNonPositiveHz(t *testing.T) {
+	screen := components.NewScreen()
+	keyboard := components.NewKeyboard()
+	fe := frontend.NewNullFrontend()
+
+	if _, err := NewLoop(&scriptedStepper{}, screen, keyboard, fe, 0); err == nil {
+		t.Error("NewLoop with hz=0 should return an error")
+	}
+	if _, err := NewLoop(&scriptedStepper{}, screen, keyboard, fe, -1); err == nil {
+		t.Error("NewLoop with hz=-1 should return an error")
+	}
+}
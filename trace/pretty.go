@@ -0,0 +1,12 @@
+package trace
+
+import "fmt"
+
+// String renders r as a single human-readable line, the format the
+// hacktrace command and test failures print.
+func (r Record) String() string {
+	return fmt.Sprintf(
+		"pc=0x%04X instr=0x%04X alu{x=0x%04X y=0x%04X out=0x%04X f=%t no=%t zr=%t ng=%t} writes=%v",
+		r.PC, r.Instruction, r.ALU.X, r.ALU.Y, r.ALU.Out, r.ALU.F, r.ALU.No, r.ALU.ZR, r.ALU.NG, r.Writes,
+	)
+}
@@ -0,0 +1,116 @@
+// Package trace records, per clock tick, the PC, current instruction,
+// ALU snapshot and any memory writes, and can replay a recorded run
+// against a fresh machine to verify it is deterministic.
+package trace
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pqkallio/hack-emulator/debug"
+)
+
+// MemWrite is one memory write that happened during a tick.
+type MemWrite struct {
+	Addr, Val uint16
+}
+
+// Record is everything recorded about a single clock tick.
+type Record struct {
+	PC          uint16
+	Instruction uint16
+	ALU         debug.ALUSnapshot
+	Writes      []MemWrite
+}
+
+// encode serializes r into the wire format Writer/Reader exchange:
+// PC, Instruction, ALU.X/Y/Out, a flags byte (f, no, zr, ng), a
+// uint16 write count, then that many (addr, val) pairs.
+func encode(r Record) []byte {
+	var buf bytes.Buffer
+
+	binary.Write(&buf, binary.BigEndian, r.PC)
+	binary.Write(&buf, binary.BigEndian, r.Instruction)
+	binary.Write(&buf, binary.BigEndian, r.ALU.X)
+	binary.Write(&buf, binary.BigEndian, r.ALU.Y)
+	binary.Write(&buf, binary.BigEndian, r.ALU.Out)
+	buf.WriteByte(encodeFlags(r.ALU))
+	binary.Write(&buf, binary.BigEndian, uint16(len(r.Writes)))
+
+	for _, w := range r.Writes {
+		binary.Write(&buf, binary.BigEndian, w.Addr)
+		binary.Write(&buf, binary.BigEndian, w.Val)
+	}
+
+	return buf.Bytes()
+}
+
+func decode(raw []byte) (Record, error) {
+	r := bytes.NewReader(raw)
+	var rec Record
+
+	if err := binary.Read(r, binary.BigEndian, &rec.PC); err != nil {
+		return Record{}, err
+	}
+	if err := binary.Read(r, binary.BigEndian, &rec.Instruction); err != nil {
+		return Record{}, err
+	}
+	if err := binary.Read(r, binary.BigEndian, &rec.ALU.X); err != nil {
+		return Record{}, err
+	}
+	if err := binary.Read(r, binary.BigEndian, &rec.ALU.Y); err != nil {
+		return Record{}, err
+	}
+	if err := binary.Read(r, binary.BigEndian, &rec.ALU.Out); err != nil {
+		return Record{}, err
+	}
+
+	var flags byte
+	if err := binary.Read(r, binary.BigEndian, &flags); err != nil {
+		return Record{}, err
+	}
+	decodeFlags(flags, &rec.ALU)
+
+	var numWrites uint16
+	if err := binary.Read(r, binary.BigEndian, &numWrites); err != nil {
+		return Record{}, err
+	}
+
+	rec.Writes = make([]MemWrite, numWrites)
+	for i := range rec.Writes {
+		if err := binary.Read(r, binary.BigEndian, &rec.Writes[i].Addr); err != nil {
+			return Record{}, err
+		}
+		if err := binary.Read(r, binary.BigEndian, &rec.Writes[i].Val); err != nil {
+			return Record{}, err
+		}
+	}
+
+	return rec, nil
+}
+
+func encodeFlags(a debug.ALUSnapshot) byte {
+	var b byte
+
+	if a.F {
+		b |= 1 << 0
+	}
+	if a.No {
+		b |= 1 << 1
+	}
+	if a.ZR {
+		b |= 1 << 2
+	}
+	if a.NG {
+		b |= 1 << 3
+	}
+
+	return b
+}
+
+func decodeFlags(b byte, a *debug.ALUSnapshot) {
+	a.F = b&(1<<0) != 0
+	a.No = b&(1<<1) != 0
+	a.ZR = b&(1<<2) != 0
+	a.NG = b&(1<<3) != 0
+}
@@ -0,0 +1,36 @@
+package trace
+
+import "github.com/pqkallio/hack-emulator/debug"
+
+// Recorder accumulates the per-tick state needed to build a Record:
+// the ALU snapshot from a debug.TappedALU, plus any memory writes
+// reported via RecordWrite since the previous tick. Wire RecordWrite
+// into the Memory decoder's write path to capture those.
+type Recorder struct {
+	alu    *debug.TappedALU
+	writes []MemWrite
+}
+
+func NewRecorder(alu *debug.TappedALU) *Recorder {
+	return &Recorder{alu: alu}
+}
+
+// RecordWrite notes a memory write made during the current tick.
+func (rec *Recorder) RecordWrite(addr, val uint16) {
+	rec.writes = append(rec.writes, MemWrite{Addr: addr, Val: val})
+}
+
+// Tick builds the Record for the tick that just ran at pc fetching
+// instruction, and clears the write log for the next tick.
+func (rec *Recorder) Tick(pc, instruction uint16) Record {
+	r := Record{
+		PC:          pc,
+		Instruction: instruction,
+		ALU:         rec.alu.Snapshot,
+		Writes:      rec.writes,
+	}
+
+	rec.writes = nil
+
+	return r
+}
@@ -0,0 +1,31 @@
+package trace
+
+import (
+	"fmt"
+
+	"github.com/pqkallio/hack-emulator/debug"
+)
+
+// Replayer re-drives a fresh machine from a recorded trace and
+// reports the first tick, if any, whose PC diverges from the trace --
+// the signature of nondeterminism or a regression against a reference
+// run.
+type Replayer struct {
+	machine debug.Stepper
+}
+
+func NewReplayer(machine debug.Stepper) *Replayer {
+	return &Replayer{machine: machine}
+}
+
+// Verify steps machine once per record in records and reports an error
+// naming the first tick whose PC doesn't match the trace.
+func (rp *Replayer) Verify(records []Record) error {
+	for i, want := range records {
+		if got := rp.machine.Step(); got != want.PC {
+			return fmt.Errorf("trace: tick %d: got pc=0x%04X, want 0x%04X", i, got, want.PC)
+		}
+	}
+
+	return nil
+}
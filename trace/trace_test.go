@@ -0,0 +1,103 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/pqkallio/hack-emulator/debug"
+)
+
+func TestWriterReaderRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	want := Record{
+		PC:          10,
+		Instruction: 0xE080,
+		ALU:         debug.ALUSnapshot{X: 5, Y: 3, Out: 8, F: true},
+		Writes:      []MemWrite{{Addr: 256, Val: 8}},
+	}
+
+	if err := w.Write(want); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	got, err := NewReader(&buf).Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestReaderReturnsEOFAtEndOfStream(t *testing.T) {
+	var buf bytes.Buffer
+
+	w := NewWriter(&buf)
+	if err := w.Write(Record{PC: 1}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	r := NewReader(&buf)
+
+	if _, err := r.Read(); err != nil {
+		t.Fatalf("first Read: %v", err)
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Fatalf("second Read err = %v, want io.EOF", err)
+	}
+}
+
+func TestReaderRejectsOversizedLength(t *testing.T) {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, uint32(maxRecordSize+1))
+
+	if _, err := NewReader(&buf).Read(); err == nil {
+		t.Fatal("expected an error for a record length over maxRecordSize")
+	}
+}
+
+func TestReaderRejectsTruncatedPayload(t *testing.T) {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, uint32(4))
+	// No payload bytes follow the length prefix.
+
+	if _, err := NewReader(&buf).Read(); err != io.ErrUnexpectedEOF {
+		t.Fatalf("err = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+type scriptedStepper struct {
+	pcs []uint16
+	i   int
+}
+
+func (s *scriptedStepper) Step() uint16 {
+	pc := s.pcs[s.i]
+	s.i++
+
+	return pc
+}
+
+func TestReplayerVerifyPassesOnMatchingRun(t *testing.T) {
+	records := []Record{{PC: 0}, {PC: 4}, {PC: 8}}
+	rp := NewReplayer(&scriptedStepper{pcs: []uint16{0, 4, 8}})
+
+	if err := rp.Verify(records); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+}
+
+func TestReplayerVerifyDetectsDivergence(t *testing.T) {
+	records := []Record{{PC: 0}, {PC: 4}, {PC: 8}}
+	rp := NewReplayer(&scriptedStepper{pcs: []uint16{0, 4, 6}})
+
+	if err := rp.Verify(records); err == nil {
+		t.Fatal("expected Verify to report the PC divergence at tick 2")
+	}
+}
@@ -0,0 +1,68 @@
+package trace
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// maxRecordSize bounds the length prefix Read will trust before
+// allocating a buffer for it, so a corrupt or truncated trace file
+// fails with a clear error instead of an enormous allocation.
+const maxRecordSize = 1 << 20
+
+// Writer appends Records to an underlying stream as length-prefixed
+// binary records, one per tick.
+type Writer struct {
+	w io.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write encodes and appends one Record.
+func (tw *Writer) Write(r Record) error {
+	buf := encode(r)
+
+	if err := binary.Write(tw.w, binary.BigEndian, uint32(len(buf))); err != nil {
+		return err
+	}
+
+	_, err := tw.w.Write(buf)
+
+	return err
+}
+
+// Reader reads length-prefixed Records written by a Writer.
+type Reader struct {
+	r io.Reader
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Read returns the next Record, or an io.EOF error once the stream is
+// exhausted.
+func (tr *Reader) Read() (Record, error) {
+	var length uint32
+
+	if err := binary.Read(tr.r, binary.BigEndian, &length); err != nil {
+		return Record{}, err
+	}
+
+	if length > maxRecordSize {
+		return Record{}, fmt.Errorf("trace: record length %d exceeds max of %d, stream is likely corrupt", length, maxRecordSize)
+	}
+
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(tr.r, buf); err != nil {
+		if err == io.EOF && length > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return Record{}, err
+	}
+
+	return decode(buf)
+}